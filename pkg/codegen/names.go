@@ -0,0 +1,184 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package codegen contains functionality that is shared by the language-specific code generators.
+package codegen
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Name is a Pulumi name, normally using camelCase, that can be rendered in the naming conventions of each target
+// language.
+type Name string
+
+// Pascal returns the name with its first character converted to upper case. It deliberately does not use Words, so
+// that existing SDK identifiers are unchanged.
+func (n Name) Pascal() string {
+	if n == "" {
+		return ""
+	}
+	runes := []rune(string(n))
+	return string(append([]rune{unicode.ToUpper(runes[0])}, runes[1:]...))
+}
+
+// Camel returns the name with all of its leading upper-case characters converted to lower case. It deliberately does
+// not use Words, so that existing SDK identifiers are unchanged.
+func (n Name) Camel() string {
+	if n == "" {
+		return ""
+	}
+	runes := []rune(string(n))
+	res := make([]rune, 0, len(runes))
+	for i, r := range runes {
+		if unicode.IsLower(r) {
+			res = append(res, runes[i:]...)
+			break
+		}
+		res = append(res, unicode.ToLower(r))
+	}
+	return string(res)
+}
+
+// Snake returns the name converted to underscore_case.
+func (n Name) Snake() string {
+	words := n.Words()
+	for i, w := range words {
+		words[i] = strings.ToLower(w)
+	}
+	return strings.Join(words, "_")
+}
+
+// Words splits the name into its component words. The case of each word is preserved.
+func (n Name) Words() []string {
+	// This method is a state machine with four states:
+	//   stateFirst - the initial state.
+	//   stateUpper - The last character we saw was an uppercase letter and the character before it
+	//                was either a number or a lowercase letter.
+	//   stateAcronym - The last character we saw was an uppercase letter and the character before it
+	//                  was an uppercase letter.
+	//   stateLowerOrNumber - The last character we saw was a lowercase letter or a number.
+	//
+	// The following are the state transitions of this state machine:
+	//   stateFirst -> (uppercase letter) -> stateUpper
+	//   stateFirst -> (lowercase letter or number) -> stateLowerOrNumber
+	//      Append the character to currentComponent.
+	//
+	//   stateUpper -> (uppercase letter) -> stateAcronym
+	//   stateUpper -> (lowercase letter or number) -> stateLowerOrNumber
+	//      Append the character to currentComponent.
+	//
+	//   stateAcronym -> (uppercase letter) -> stateAcronym
+	//		Append the character to currentComponent.
+	//   stateAcronym -> (number) -> stateLowerOrNumber
+	//      Append the character to currentComponent.
+	//   stateAcronym -> (lowercase letter) -> stateLowerOrNumber
+	//      Take all but the last character in currentComponent, turn that into
+	//      a string, and append that to components. Set currentComponent to the
+	//      last two characters seen.
+	//
+	//   stateLowerOrNumber -> (uppercase letter) -> stateUpper
+	//      Take all characters in currentComponent, turn that into a string,
+	//      and append that to components. Set currentComponent to the last
+	//      character seen.
+	//	 stateLowerOrNumber -> (lowercase letter) -> stateLowerOrNumber
+	//      Append the character to currentComponent.
+	//
+	// The Go libraries that convert camelCase to snake_case deviate subtly from
+	// the semantics we're going for in this method, namely that they separate
+	// numbers and lowercase letters. We don't want this in all cases (we want e.g. Sha256Hash to
+	// be converted as sha256_hash). We also want SHA256Hash to be converted as sha256_hash, so
+	// we must at least be aware of digits when in the stateAcronym state.
+	//
+	// As for why this is a state machine, the libraries that do this all pretty much use
+	// either regular expressions or state machines, which I suppose are ultimately the same thing.
+	const (
+		stateFirst = iota
+		stateUpper
+		stateAcronym
+		stateLowerOrNumber
+	)
+
+	var components []string     // The components that make up the name
+	var currentComponent []rune // The characters composing the current component being built
+	state := stateFirst
+	for _, char := range string(n) {
+		switch state {
+		case stateFirst:
+			if unicode.IsUpper(char) {
+				// stateFirst -> stateUpper
+				state = stateUpper
+				currentComponent = append(currentComponent, char)
+				continue
+			}
+
+			// stateFirst -> stateLowerOrNumber
+			state = stateLowerOrNumber
+			currentComponent = append(currentComponent, char)
+			continue
+
+		case stateUpper:
+			if unicode.IsUpper(char) {
+				// stateUpper -> stateAcronym
+				state = stateAcronym
+				currentComponent = append(currentComponent, char)
+				continue
+			}
+
+			// stateUpper -> stateLowerOrNumber
+			state = stateLowerOrNumber
+			currentComponent = append(currentComponent, char)
+			continue
+
+		case stateAcronym:
+			if unicode.IsUpper(char) {
+				// stateAcronym -> stateAcronym
+				currentComponent = append(currentComponent, char)
+				continue
+			}
+
+			// We want to fold digits immediately following an acronym into the same
+			// component as the acronym.
+			if unicode.IsDigit(char) {
+				// stateAcronym -> stateLowerOrNumber
+				currentComponent = append(currentComponent, char)
+				state = stateLowerOrNumber
+				continue
+			}
+
+			// stateAcronym -> stateLowerOrNumber
+			last, rest := currentComponent[len(currentComponent)-1], currentComponent[:len(currentComponent)-1]
+			components = append(components, string(rest))
+			currentComponent = []rune{last, char}
+			state = stateLowerOrNumber
+			continue
+
+		case stateLowerOrNumber:
+			if unicode.IsUpper(char) {
+				// stateLowerOrNumber -> stateUpper
+				components = append(components, string(currentComponent))
+				currentComponent = []rune{char}
+				state = stateUpper
+				continue
+			}
+
+			// stateLowerOrNumber -> stateLowerOrNumber
+			currentComponent = append(currentComponent, char)
+			continue
+		}
+	}
+
+	return append(components, string(currentComponent))
+}
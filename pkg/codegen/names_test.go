@@ -0,0 +1,84 @@
+// Copyright 2016-2020, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNames(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		camel  string
+		pascal string
+		snake  string
+	}{
+		{"", "", "", ""},
+		{"bucket", "bucket", "Bucket", "bucket"},
+		{"bucketName", "bucketName", "BucketName", "bucket_name"},
+		{"BucketName", "bucketName", "BucketName", "bucket_name"},
+		{"VPC", "vpc", "VPC", "vpc"},
+		{"vpcID", "vpcID", "VpcID", "vpc_id"},
+		{"Sha256Hash", "sha256Hash", "Sha256Hash", "sha256_hash"},
+		{"x509Certificate", "x509Certificate", "X509Certificate", "x509_certificate"},
+		{"resource_group", "resource_group", "Resource_group", "resource_group"},
+		{"_internal", "_internal", "_internal", "_internal"},
+	}
+	for _, c := range cases {
+		n := Name(c.name)
+		assert.Equal(t, c.camel, n.Camel(), "Camel(%q)", c.name)
+		assert.Equal(t, c.pascal, n.Pascal(), "Pascal(%q)", c.name)
+		assert.Equal(t, c.snake, n.Snake(), "Snake(%q)", c.name)
+	}
+}
+
+func TestNameWords(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"bucket", "Name"}, Name("bucketName").Words())
+	assert.Equal(t, []string{"HTTPS", "Proxy"}, Name("HTTPSProxy").Words())
+	assert.Equal(t, []string{"SHA256", "Hash"}, Name("SHA256Hash").Words())
+	assert.Equal(t, []string{"3", "Scale"}, Name("3Scale").Words())
+
+	assert.Equal(t, "sha256_hash", Name("SHA256Hash").Snake())
+	assert.Equal(t, "https_proxy", Name("HTTPSProxy").Snake())
+	assert.Equal(t, "3_scale", Name("3Scale").Snake())
+}
+
+// TestNamesLegacy covers names for which Camel and Pascal disagree with Words. Camel and Pascal keep the behavior of
+// the generators' original helpers so that existing SDK identifiers do not change; these cases are not the intended
+// tokenization.
+func TestNamesLegacy(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		camel  string
+		pascal string
+	}{
+		{"SHA256Hash", "sha256hash", "SHA256Hash"},
+		{"HTTPSProxy", "httpsproxy", "HTTPSProxy"},
+		{"3Scale", "3scale", "3Scale"},
+	}
+	for _, c := range cases {
+		n := Name(c.name)
+		assert.Equal(t, c.camel, n.Camel(), "Camel(%q)", c.name)
+		assert.Equal(t, c.pascal, n.Pascal(), "Pascal(%q)", c.name)
+	}
+}